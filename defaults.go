@@ -0,0 +1,38 @@
+package configkit
+
+import "runtime/debug"
+
+// Defaults selects which of a MetaField's DevDefault/ReleaseDefault values Value() resolves
+// to when both are set.
+type Defaults int
+
+const (
+	// DefaultsRelease resolves to ReleaseDefault. It is the package default, so binaries are
+	// safe-by-default unless a developer opts into DefaultsDev.
+	DefaultsRelease Defaults = iota
+	// DefaultsDev resolves to DevDefault.
+	DefaultsDev
+	// DefaultsAuto picks DefaultsDev for `go run`/unversioned builds and DefaultsRelease
+	// otherwise, based on the main module's build info.
+	DefaultsAuto
+)
+
+var defaultsMode = DefaultsRelease
+
+// SetDefaultsMode sets the process-wide Defaults mode consulted by Value() when a MetaField
+// has both a DevDefault and a ReleaseDefault. It is typically wired to a `--defaults` global
+// flag and called once, early in main, before any MetaField is resolved.
+func SetDefaultsMode(mode Defaults) {
+	defaultsMode = mode
+}
+
+func resolveDefaultsMode() Defaults {
+	if defaultsMode != DefaultsAuto {
+		return defaultsMode
+	}
+	info, ok := debug.ReadBuildInfo()
+	if ok && info.Main.Version == "(devel)" {
+		return DefaultsDev
+	}
+	return DefaultsRelease
+}
@@ -0,0 +1,237 @@
+// Package docgen generates reference documentation from a configkit metaConfig: a
+// CONFIG.md-style Markdown reference, or a commented YAML template with defaults filled in.
+package docgen
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gatecheckdev/configkit"
+)
+
+// group is a nested struct of MetaFields, collected once and rendered by both generators.
+type group struct {
+	name     string
+	fields   []configkit.MetaField
+	children []group
+}
+
+// GenerateMarkdown walks cfg and writes a reference doc to w: a headed section per nested
+// struct, and for each leaf field its flag name, env var, default, and usage. cfg is either a
+// metaConfig struct (or pointer to one) built from nested structs of configkit.MetaField, or a
+// real Config struct (or pointer to one) annotated with `configkit:"..."` tags for
+// configkit.RegisterStruct.
+//
+// Panic Conditions:
+//
+//   - If cfg is not a struct or a pointer to a struct
+func GenerateMarkdown(w io.Writer, cfg any) error {
+	g, err := collectConfigGroup(cfg)
+	if err != nil {
+		return err
+	}
+	return writeMarkdownGroup(w, g, 1)
+}
+
+// GenerateMarkdownFields writes the same per-field descriptions as GenerateMarkdown, but from
+// an already-flattened list of MetaFields such as the one configkit.RegisterStruct returns.
+// Since a flat list carries no struct nesting, there are no headed subsections.
+func GenerateMarkdownFields(w io.Writer, fields []configkit.MetaField) error {
+	for _, field := range fields {
+		if err := writeMarkdownField(w, field, 2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateYAMLTemplate walks cfg the same way as GenerateMarkdown and writes a commented YAML
+// template: one nested mapping per struct, one key per leaf field, its default value filled in,
+// and its usage text as a comment above the key. A field with a DevDefault or ReleaseDefault
+// gets both, as `dev=<value>, release=<value>`, rather than the one the active Defaults mode
+// selects.
+//
+// Panic Conditions:
+//
+//   - If cfg is not a struct or a pointer to a struct
+func GenerateYAMLTemplate(w io.Writer, cfg any) error {
+	g, err := collectConfigGroup(cfg)
+	if err != nil {
+		return err
+	}
+	return writeYAMLGroup(w, g, 0)
+}
+
+func reflectStruct(cfg any) reflect.Value {
+	val := reflect.ValueOf(cfg)
+	if val.Kind() == reflect.Pointer {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		panic(errors.New("docgen: input is not a struct or a pointer to a struct"))
+	}
+	return val
+}
+
+// collectConfigGroup builds the group tree to render. If cfg is a metaConfig of
+// configkit.MetaField (the hand-built style), it's walked directly. Otherwise cfg is assumed to
+// be a real Config struct carrying `configkit:"..."` tags, and is run through
+// configkit.RegisterStruct (against a throwaway copy, with no cobra.Command) to get the same
+// MetaFields RegisterStruct would hand an application, grouped back into a hierarchy by
+// splitting each one's dotted Path.
+func collectConfigGroup(cfg any) (group, error) {
+	val := reflectStruct(cfg)
+
+	if len(configkit.AllMetaFields(val.Interface())) > 0 {
+		return collectGroup(val, ""), nil
+	}
+
+	ptr := reflect.New(val.Type())
+	ptr.Elem().Set(val)
+	fields, err := configkit.RegisterStruct(ptr.Interface(), nil)
+	if err != nil {
+		return group{}, fmt.Errorf("docgen: %w", err)
+	}
+	return groupFromFields(fields), nil
+}
+
+// groupFromFields rebuilds the struct hierarchy a flat []MetaField lost, by splitting each
+// field's dotted Path back into its parent groups.
+func groupFromFields(fields []configkit.MetaField) group {
+	root := group{}
+	for _, field := range fields {
+		segments := strings.Split(field.Path, ".")
+		cur := &root
+		for _, seg := range segments[:len(segments)-1] {
+			cur = childGroup(cur, seg)
+		}
+		cur.fields = append(cur.fields, field)
+	}
+	return root
+}
+
+func childGroup(g *group, name string) *group {
+	for i := range g.children {
+		if g.children[i].name == name {
+			return &g.children[i]
+		}
+	}
+	g.children = append(g.children, group{name: name})
+	return &g.children[len(g.children)-1]
+}
+
+func collectGroup(val reflect.Value, name string) group {
+	g := group{name: name}
+
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Field(i)
+		if !field.CanInterface() {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			field = field.Elem()
+		}
+		if field.Kind() != reflect.Struct {
+			continue
+		}
+
+		if metaField, ok := field.Interface().(configkit.MetaField); ok {
+			g.fields = append(g.fields, metaField)
+			continue
+		}
+
+		g.children = append(g.children, collectGroup(field, val.Type().Field(i).Name))
+	}
+
+	return g
+}
+
+func writeMarkdownGroup(w io.Writer, g group, depth int) error {
+	if g.name != "" {
+		if _, err := fmt.Fprintf(w, "%s %s\n\n", strings.Repeat("#", depth), g.name); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range g.fields {
+		if err := writeMarkdownField(w, field, depth+1); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range g.children {
+		if err := writeMarkdownGroup(w, child, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeMarkdownField(w io.Writer, field configkit.MetaField, depth int) error {
+	lines := []string{
+		fmt.Sprintf("%s %s\n", strings.Repeat("#", depth), field.FieldName),
+		fmt.Sprintf("- Flag: `--%s`\n", field.Metadata["flag_name"]),
+	}
+	if field.EnvKey != "" {
+		lines = append(lines, fmt.Sprintf("- Env: `%s`\n", field.EnvKey))
+	}
+	lines = append(lines, fmt.Sprintf("- Default: `%s`\n", defaultDisplay(field)))
+	if usage := field.Metadata["flag_usage"]; usage != "" {
+		lines = append(lines, fmt.Sprintf("- Usage: %s\n", usage))
+	}
+	lines = append(lines, "\n")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeYAMLGroup(w io.Writer, g group, depth int) error {
+	indent := strings.Repeat("  ", depth)
+
+	for _, field := range g.fields {
+		if usage := field.Metadata["flag_usage"]; usage != "" {
+			if _, err := fmt.Fprintf(w, "%s# %s\n", indent, usage); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s%s: %s\n", indent, yamlKey(field.FieldName), defaultDisplay(field)); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range g.children {
+		if _, err := fmt.Fprintf(w, "%s%s:\n", indent, yamlKey(child.name)); err != nil {
+			return err
+		}
+		if err := writeYAMLGroup(w, child, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func defaultDisplay(field configkit.MetaField) string {
+	if field.DevDefault != nil || field.ReleaseDefault != nil {
+		return fmt.Sprintf("dev=%v, release=%v", field.DevDefault, field.ReleaseDefault)
+	}
+	return fmt.Sprintf("%v", field.DefaultValue)
+}
+
+func yamlKey(name string) string {
+	if name == "" {
+		return name
+	}
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gatecheckdev/configkit"
+	"github.com/spf13/cobra"
+)
+
+type Config struct {
+	FieldOne string `configkit:"flag=field-one,env=APP_FIELD_ONE,default=default value,usage=the first value as a string"`
+}
+
+func main() {
+	cmd := &cobra.Command{Use: "app"}
+
+	config := Config{}
+	fields, err := configkit.RegisterStruct(&config, cmd)
+	if err != nil {
+		panic(err)
+	}
+
+	// /etc/app/config.yaml:
+	//   fieldOne: from base file
+	//
+	// ~/.app.yaml:
+	//   fieldOne: from user file
+	if err := configkit.LoadFile("/etc/app/config.yaml", nil); err != nil {
+		panic(err)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		panic(err)
+	}
+	userFile := filepath.Join(home, ".app.yaml")
+	if err := configkit.LoadFile(userFile, nil); err != nil {
+		panic(err)
+	}
+
+	if err := configkit.WatchFile(userFile, nil, func(diff []string) {
+		fmt.Println("config changed:", diff)
+	}); err != nil {
+		panic(err)
+	}
+
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		if err := configkit.ApplyRegistered(fields); err != nil {
+			panic(err)
+		}
+		fmt.Printf("%+v\n", config)
+		// {FieldOne:from user file} -- later file wins over earlier file, which wins over default
+	}
+
+	if err := cmd.Execute(); err != nil {
+		panic(err)
+	}
+}
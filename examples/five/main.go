@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gatecheckdev/configkit"
+)
+
+type KeyringConfig struct {
+	Backend string
+	TTLSecs int
+}
+
+type Config struct {
+	Name      string
+	Keyring   KeyringConfig
+	SubConfig *struct {
+		FieldFour int
+	}
+}
+
+func main() {
+	config := Config{Name: "base", Keyring: KeyringConfig{Backend: "file", TTLSecs: 30}}
+
+	for _, path := range configkit.Paths(&config) {
+		fmt.Println(path)
+	}
+	// Name
+	// Keyring.Backend
+	// Keyring.TTLSecs
+	// SubConfig.FieldFour
+
+	if err := configkit.SetByPath(&config, "Keyring.Backend", "os"); err != nil {
+		panic(err)
+	}
+	// SubConfig is nil until something is written through it, at which point it's allocated.
+	if err := configkit.SetByPath(&config, "SubConfig.FieldFour", "7"); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", config)
+	// {Name:base Keyring:{Backend:os TTLSecs:30} SubConfig:0xc0000...}
+	// (SubConfig now points to {FieldFour:7})
+
+	options := configkit.FieldOptions(&config)
+	fmt.Println(options["Keyring.Backend"].Get())
+	// os
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gatecheckdev/configkit"
+	"github.com/spf13/cobra"
+)
+
+type Config struct {
+	FieldOne   string `configkit:"flag=field-one,env=APP_FIELD_ONE,default=default value,usage=the first value as a string"`
+	FieldTwo   int    `configkit:"flag=field-two,env=APP_FIELD_TWO,default=1,usage=the second value as a int"`
+	FieldThree bool   `configkit:"flag=field-three,env=APP_FIELD_THREE,usage=the third value as a bool"`
+	SubConfig  struct {
+		FieldFour int `configkit:"flag=field-four,env=FIELD_FOUR,default=2,usage=an example of a sub field in a nested config"`
+	} `configkit:"prefix=sub"`
+}
+
+func main() {
+	cmd := &cobra.Command{Use: "app"}
+
+	config := Config{}
+	fields, err := configkit.RegisterStruct(&config, cmd)
+	if err != nil {
+		panic(err)
+	}
+
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		if err := configkit.ApplyRegistered(fields); err != nil {
+			panic(err)
+		}
+		fmt.Printf("%+v\n", config)
+	}
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+
+	// go run ./examples/four
+	//
+	// {FieldOne:default value FieldTwo:1 FieldThree:false SubConfig:{FieldFour:2}}
+	//
+	// The SubConfig.FieldFour flag and env key are prefixed with "sub" from the
+	// `configkit:"prefix=sub"` tag: --sub-field-four / SUB_FIELD_FOUR
+}
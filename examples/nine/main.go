@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gatecheckdev/configkit"
+)
+
+func main() {
+	name := configkit.MetaField{
+		FieldName: "Name",
+		Required:  true,
+	}
+	port := configkit.MetaField{
+		FieldName:    "Port",
+		DefaultValue: 8080,
+		Validators:   []func(any) error{configkit.IntRange(1, 65535)},
+	}
+
+	cfg := struct {
+		Name configkit.MetaField
+		Port configkit.MetaField
+	}{Name: name, Port: port}
+
+	err := configkit.Validate(cfg)
+	fmt.Println(err)
+	// Name: value is required
+
+	cfg.Name.DefaultValue = "service"
+	fmt.Println(configkit.Validate(cfg))
+	// <nil>
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+
+	"github.com/gatecheckdev/configkit"
+	"github.com/gatecheckdev/configkit/docgen"
+)
+
+var metaConfig = struct {
+	Name configkit.MetaField
+	Sub  struct {
+		FieldFour configkit.MetaField
+	}
+}{
+	Name: configkit.MetaField{
+		FieldName:    "Name",
+		EnvKey:       "APP_NAME",
+		DefaultValue: "some default",
+		Metadata: map[string]string{
+			"flag_name":  "name",
+			"flag_usage": "a name flag",
+		},
+	},
+	Sub: struct {
+		FieldFour configkit.MetaField
+	}{
+		FieldFour: configkit.MetaField{
+			FieldName:    "FieldFour",
+			EnvKey:       "APP_SUB_FIELD_FOUR",
+			DefaultValue: 2,
+			Metadata: map[string]string{
+				"flag_name":  "sub-field-four",
+				"flag_usage": "an example of a sub field in a nested config",
+			},
+		},
+	},
+}
+
+func main() {
+	if err := docgen.GenerateMarkdown(os.Stdout, metaConfig); err != nil {
+		panic(err)
+	}
+	if err := docgen.GenerateYAMLTemplate(os.Stdout, metaConfig); err != nil {
+		panic(err)
+	}
+
+	// go run ./examples/seven
+	//
+	// ## Name
+	// - Flag: `--name`
+	// - Env: `APP_NAME`
+	// - Default: `some default`
+	// - Usage: a name flag
+	//
+	// ## Sub
+	//
+	// ### FieldFour
+	// ...
+	//
+	// # a name flag
+	// name: some default
+	// sub:
+	//   # an example of a sub field in a nested config
+	//   fieldFour: 2
+}
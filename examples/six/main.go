@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gatecheckdev/configkit"
+	"github.com/spf13/cobra"
+)
+
+type Config struct {
+	// In a dev build, logging defaults to debug; in a release build it defaults to info.
+	LogLevel string `configkit:"flag=log-level,env=APP_LOG_LEVEL,devDefault=debug,releaseDefault=info,usage=the log level"`
+}
+
+func main() {
+	cmd := &cobra.Command{Use: "app"}
+	defaultsMode := cmd.PersistentFlags().String("defaults", "release", "selects dev or release defaults (dev|release)")
+
+	config := Config{}
+	fields, err := configkit.RegisterStruct(&config, cmd)
+	if err != nil {
+		panic(err)
+	}
+
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		if *defaultsMode == "dev" {
+			configkit.SetDefaultsMode(configkit.DefaultsDev)
+		}
+		if err := configkit.ApplyRegistered(fields); err != nil {
+			panic(err)
+		}
+		fmt.Printf("%+v\n", config)
+	}
+
+	if err := cmd.Execute(); err != nil {
+		panic(err)
+	}
+
+	// go run ./examples/six               -> {LogLevel:info}
+	// go run ./examples/six --defaults=dev -> {LogLevel:debug}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gatecheckdev/configkit"
+)
+
+func main() {
+	timeout := configkit.MetaField{
+		FieldName:    "Timeout",
+		EnvKey:       "APP_TIMEOUT",
+		DefaultValue: 5 * time.Second,
+	}
+	maxUpload := configkit.MetaField{
+		FieldName:    "MaxUpload",
+		EnvKey:       "APP_MAX_UPLOAD",
+		DefaultValue: configkit.ByteSize(10 << 20), // 10MiB
+	}
+
+	fmt.Printf("Timeout: %v, MaxUpload: %v\n", timeout.Value(), maxUpload.Value())
+
+	os.Setenv("APP_TIMEOUT", "30s")
+	os.Setenv("APP_MAX_UPLOAD", "64MiB")
+	fmt.Printf("Timeout: %v, MaxUpload: %v\n", timeout.Value(), maxUpload.Value())
+
+	// go run ./examples/ten
+	//
+	// Timeout: 5s, MaxUpload: 10485760
+	// Timeout: 30s, MaxUpload: 67108864
+	//
+	// Neither field sets EnvToValueFunc: the time.Duration and configkit.ByteSize parsers are
+	// picked up from the registry by their DefaultValue's type.
+}
@@ -0,0 +1,235 @@
+package configkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+)
+
+// fileLayer is one file's worth of values, flattened and keyed by normalizeKey of their dotted
+// path, so a file's own key casing (flagName, snake_case, ...) doesn't have to match the exact
+// PascalCase Go field names MetaField.Path is built from.
+type fileLayer struct {
+	path   string
+	values map[string]any
+}
+
+var (
+	fileLayersMu sync.RWMutex
+	fileLayers   []fileLayer
+)
+
+// LoadFile parses a YAML, JSON, or TOML file, selected by its extension, and registers it as a
+// source for FileRule, matched against MetaField.Path case-insensitively and ignoring any `-`
+// or `_` separators, so a file can use whatever key style is natural for its format. Calling
+// LoadFile again for a path already loaded replaces that file's layer in place; loading
+// additional, different paths stacks them, with later files overriding earlier ones only for
+// the keys they actually set.
+//
+// If cfg is non-nil, the parsed values are also written directly onto cfg via SetByPath, for
+// callers working against a plain config struct rather than a metaConfig of MetaFields.
+func LoadFile(path string, cfg any) error {
+	values, err := parseFile(path)
+	if err != nil {
+		return err
+	}
+
+	setFileLayer(path, values)
+
+	if cfg == nil {
+		return nil
+	}
+	return applyFileValues(cfg, values)
+}
+
+// applyFileValues writes values, keyed by normalizeKey, onto cfg by matching each of cfg's own
+// dotted paths (from Paths) against that same normalization.
+func applyFileValues(cfg any, values map[string]any) error {
+	for _, path := range Paths(cfg) {
+		val, ok := values[normalizeKey(path)]
+		if !ok {
+			continue
+		}
+		if err := SetByPath(cfg, path, fmt.Sprintf("%v", val)); err != nil {
+			return fmt.Errorf("configkit: %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// FileRule resolves a MetaField's value from the layers registered via LoadFile, in load
+// order, so a later file overrides an earlier one only for the paths it sets. It returns nil,
+// deferring to the next rule, if m.Path isn't present in any loaded file.
+func FileRule(m MetaField) any {
+	if m.Path == "" {
+		return nil
+	}
+	key := normalizeKey(m.Path)
+
+	fileLayersMu.RLock()
+	defer fileLayersMu.RUnlock()
+
+	var result any
+	found := false
+	for _, layer := range fileLayers {
+		if v, ok := layer.values[key]; ok {
+			result = v
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return result
+}
+
+// WatchFile watches path for changes using fsnotify and, on write or create events, reloads it
+// with LoadFile and invokes onChange with the sorted keys whose value in this file was changed,
+// added, or removed. The keys are in normalizeKey form (e.g. "sub.fieldfour"), and only this
+// file's own values are compared: MetaField rules are not re-run, so a reported key may still
+// resolve to the same value through a flag, an env var, or a later-loaded file. The watch runs
+// in a background goroutine for the lifetime of the process.
+func WatchFile(path string, cfg any, onChange func(diff []string)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("configkit: watching %s: %w", path, err)
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return fmt.Errorf("configkit: watching %s: %w", path, err)
+	}
+
+	go func() {
+		defer watcher.Close()
+		for event := range watcher.Events {
+			if filepath.Clean(event.Name) != filepath.Clean(path) {
+				continue
+			}
+			if !event.Op.Has(fsnotify.Write) && !event.Op.Has(fsnotify.Create) {
+				continue
+			}
+
+			before := layerValues(path)
+			if err := LoadFile(path, cfg); err != nil {
+				continue
+			}
+			onChange(changedPaths(before, layerValues(path)))
+		}
+	}()
+
+	return nil
+}
+
+func setFileLayer(path string, values map[string]any) {
+	fileLayersMu.Lock()
+	defer fileLayersMu.Unlock()
+
+	for i, layer := range fileLayers {
+		if layer.path == path {
+			fileLayers[i].values = values
+			return
+		}
+	}
+	fileLayers = append(fileLayers, fileLayer{path: path, values: values})
+}
+
+func layerValues(path string) map[string]any {
+	fileLayersMu.RLock()
+	defer fileLayersMu.RUnlock()
+
+	for _, layer := range fileLayers {
+		if layer.path == path {
+			return layer.values
+		}
+	}
+	return nil
+}
+
+func changedPaths(before, after map[string]any) []string {
+	var diff []string
+	for key, val := range after {
+		prev, ok := before[key]
+		if !ok || fmt.Sprintf("%v", prev) != fmt.Sprintf("%v", val) {
+			diff = append(diff, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			diff = append(diff, key)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+func parseFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("configkit: reading %s: %w", path, err)
+	}
+
+	raw := map[string]any{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("configkit: unsupported config file extension %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("configkit: parsing %s: %w", path, err)
+	}
+
+	flat := map[string]any{}
+	flatten("", raw, flat)
+	return flat, nil
+}
+
+func flatten(prefix string, in map[string]any, out map[string]any) {
+	for key, val := range in {
+		path := joinPrefix(prefix, key, ".")
+
+		switch nested := val.(type) {
+		case map[string]any:
+			flatten(path, nested, out)
+		case map[any]any:
+			converted := make(map[string]any, len(nested))
+			for k, v := range nested {
+				converted[fmt.Sprintf("%v", k)] = v
+			}
+			flatten(path, converted, out)
+		default:
+			out[normalizeKey(path)] = val
+		}
+	}
+}
+
+// normalizeKey lowercases a dotted path and drops any `-`/`_` word separators within each
+// segment, so "Sub.FieldFour", "sub.fieldFour", and "sub.field_four" all collapse to the same
+// key. "." segment separators are preserved.
+func normalizeKey(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		switch {
+		case r == '.':
+			b.WriteRune(r)
+		case r == '-' || r == '_':
+			// drop
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
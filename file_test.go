@@ -0,0 +1,182 @@
+package configkit
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type fileTestSub struct {
+	FieldFour int
+}
+
+type fileTestConfig struct {
+	FieldOne string
+	Sub      fileTestSub
+}
+
+func writeTestFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+	return path
+}
+
+func TestFileRuleMatchesLowerCamelCaseKeys(t *testing.T) {
+	path := writeTestFile(t, "config.yaml", "fieldOne: from file\nsub:\n  fieldFour: 7\n")
+
+	t.Cleanup(func() { setFileLayer(path, nil) })
+	if err := LoadFile(path, nil); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	fieldOne := MetaField{FieldName: "FieldOne", Path: "FieldOne"}
+	if got := FileRule(fieldOne); got != "from file" {
+		t.Errorf("FileRule(FieldOne) = %v, want %q", got, "from file")
+	}
+
+	fieldFour := MetaField{FieldName: "FieldFour", Path: "Sub.FieldFour"}
+	if got := FileRule(fieldFour); got != 7 {
+		t.Errorf("FileRule(Sub.FieldFour) = %v, want %v", got, 7)
+	}
+}
+
+func TestLoadFileAppliesToConfigByNormalizedPath(t *testing.T) {
+	path := writeTestFile(t, "config.yaml", "fieldOne: from file\nsub:\n  fieldFour: 7\n")
+	t.Cleanup(func() { setFileLayer(path, nil) })
+
+	cfg := fileTestConfig{}
+	if err := LoadFile(path, &cfg); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	if cfg.FieldOne != "from file" {
+		t.Errorf("FieldOne = %q, want %q", cfg.FieldOne, "from file")
+	}
+	if cfg.Sub.FieldFour != 7 {
+		t.Errorf("Sub.FieldFour = %d, want %d", cfg.Sub.FieldFour, 7)
+	}
+}
+
+func TestLoadFileLaterFileOverridesEarlier(t *testing.T) {
+	base := writeTestFile(t, "base.yaml", "fieldOne: from base\n")
+	override := writeTestFile(t, "override.yaml", "fieldOne: from override\n")
+	t.Cleanup(func() {
+		setFileLayer(base, nil)
+		setFileLayer(override, nil)
+	})
+
+	if err := LoadFile(base, nil); err != nil {
+		t.Fatalf("LoadFile(base): %v", err)
+	}
+	if err := LoadFile(override, nil); err != nil {
+		t.Fatalf("LoadFile(override): %v", err)
+	}
+
+	fieldOne := MetaField{FieldName: "FieldOne", Path: "FieldOne"}
+	if got := FileRule(fieldOne); got != "from override" {
+		t.Errorf("FileRule(FieldOne) = %v, want %q", got, "from override")
+	}
+}
+
+type fileTestDurationConfig struct {
+	Timeout time.Duration
+}
+
+type fileTestDurationMetaConfig struct {
+	Timeout MetaField
+}
+
+// TestApplyValuesCoercesFileSourcedDuration reproduces a prior panic: FileRule hands back the
+// raw string a YAML parser decoded ("5s"), and ApplyValues set it straight onto a time.Duration
+// field without reparsing, panicking with "reflect.Set: value of type string is not assignable
+// to type time.Duration".
+func TestApplyValuesCoercesFileSourcedDuration(t *testing.T) {
+	path := writeTestFile(t, "config.yaml", "timeout: 5s\n")
+	t.Cleanup(func() { setFileLayer(path, nil) })
+
+	if err := LoadFile(path, nil); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	cfg := fileTestDurationConfig{}
+	metaCfg := fileTestDurationMetaConfig{
+		Timeout: MetaField{FieldName: "Timeout", Path: "Timeout"},
+	}
+
+	if err := ApplyValues(&cfg, metaCfg); err != nil {
+		t.Fatalf("ApplyValues: %v", err)
+	}
+	if cfg.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want %v", cfg.Timeout, 5*time.Second)
+	}
+}
+
+type fileTestRegisterConfig struct {
+	Timeout time.Duration `configkit:"flag=timeout,env=FILE_TEST_TIMEOUT"`
+}
+
+// TestApplyRegisteredCoercesFileSourcedDuration is the same reproduction as
+// TestApplyValuesCoercesFileSourcedDuration, but through the RegisterStruct/ApplyRegistered
+// path, which needed the same fix applied independently since it didn't go through ApplyValues
+// at all.
+func TestApplyRegisteredCoercesFileSourcedDuration(t *testing.T) {
+	path := writeTestFile(t, "config.yaml", "timeout: 5s\n")
+	t.Cleanup(func() { setFileLayer(path, nil) })
+
+	if err := LoadFile(path, nil); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+
+	cmd := &cobra.Command{Use: "test", Run: func(cmd *cobra.Command, args []string) {}}
+	cfg := fileTestRegisterConfig{}
+	fields, err := RegisterStruct(&cfg, cmd)
+	if err != nil {
+		t.Fatalf("RegisterStruct: %v", err)
+	}
+	cmd.SetArgs([]string{})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if err := ApplyRegistered(fields); err != nil {
+		t.Fatalf("ApplyRegistered: %v", err)
+	}
+
+	if cfg.Timeout != 5*time.Second {
+		t.Errorf("Timeout = %v, want %v", cfg.Timeout, 5*time.Second)
+	}
+}
+
+// TestFileLayersConcurrentAccess exercises setFileLayer and FileRule from concurrent
+// goroutines, the pattern WatchFile's reload goroutine and a resolving goroutine produce in a
+// long-running service. Run with -race to verify fileLayers is actually synchronized.
+func TestFileLayersConcurrentAccess(t *testing.T) {
+	path := writeTestFile(t, "config.yaml", "fieldOne: initial\n")
+	t.Cleanup(func() { setFileLayer(path, nil) })
+
+	field := MetaField{FieldName: "FieldOne", Path: "FieldOne"}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = LoadFile(path, nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = FileRule(field)
+		}
+	}()
+
+	wg.Wait()
+}
@@ -9,14 +9,36 @@ import (
 )
 
 type MetaField struct {
-	FieldName      string
+	FieldName string
+	// Path is the dotted path to this field from its config struct's root, e.g.
+	// "SubConfig.FieldFour". RegisterStruct sets it; a hand-built MetaField has it only if set
+	// explicitly. It is what FileRule looks file values up by.
+	Path           string
 	EnvKey         string
 	DefaultValue   any
+	DevDefault     any
+	ReleaseDefault any
 	FlagValueP     any
 	Metadata       map[string]string
 	CobraSetupFunc func(f MetaField, cmd *cobra.Command)
 	EnvToValueFunc func(s string) any
 	ValueRules     []func(m MetaField) any
+
+	// FlagChanged reports whether the user actually passed this field's flag on the command
+	// line, as opposed to cobra having written its default value into *FlagValueP. It's set by
+	// RegisterStruct; hand-built MetaFields that leave it nil fall back to flagRule's IsZero
+	// heuristic.
+	FlagChanged func() bool
+
+	// Required fails Validate when the resolved value is the zero value of its type and no
+	// DefaultValue, DevDefault, or ReleaseDefault was supplied. Use Validators for anything
+	// more specific.
+	Required   bool
+	Validators []func(any) error
+
+	// target is the struct field this MetaField was generated from when built by
+	// RegisterStruct. It is unset for hand-built MetaFields.
+	target reflect.Value
 }
 
 func (m MetaField) defaultRules() []func(m MetaField) any {
@@ -24,6 +46,12 @@ func (m MetaField) defaultRules() []func(m MetaField) any {
 		if m.FlagValueP == nil {
 			return nil
 		}
+		if m.FlagChanged != nil {
+			if !m.FlagChanged() {
+				return nil
+			}
+			return reflect.ValueOf(m.FlagValueP).Elem().Interface()
+		}
 		val := reflect.ValueOf(m.FlagValueP).Elem()
 		if val.IsZero() {
 			return nil
@@ -39,26 +67,65 @@ func (m MetaField) defaultRules() []func(m MetaField) any {
 		if value == "" {
 			return nil
 		}
-		envToValueFunc := m.EnvToValueFunc
-		if envToValueFunc == nil {
-			envToValueFunc = func(s string) any {
-				return value
+		if m.EnvToValueFunc != nil {
+			return m.EnvToValueFunc(value)
+		}
+		if t := m.valueType(); t != nil {
+			if parser, ok := ParserFor(t); ok {
+				parsed, err := parser(value)
+				if err != nil {
+					// A registered parser exists for this field's type but rejected the raw env
+					// value; falling through to the unparsed string would hand ApplyValues a
+					// type it can't reflect.Set, panicking later instead of here. Deferring to
+					// the next rule at least resolves to a value of the right type.
+					return nil
+				}
+				return parsed
 			}
 		}
-		return envToValueFunc(value)
+		return value
 	}
 
 	defaultValueRule := func(m MetaField) any {
+		if resolveDefaultsMode() == DefaultsDev && m.DevDefault != nil {
+			return m.DevDefault
+		}
+		if resolveDefaultsMode() == DefaultsRelease && m.ReleaseDefault != nil {
+			return m.ReleaseDefault
+		}
 		return m.DefaultValue
 	}
 
 	return []func(m MetaField) any{
 		flagRule,
 		envRule,
+		FileRule,
 		defaultValueRule,
 	}
 }
 
+// valueType returns the Go type this MetaField resolves to, if known: the struct field it was
+// built from by RegisterStruct, or else the type pointed to by FlagValueP, or else the type of
+// the first non-nil DefaultValue, DevDefault, or ReleaseDefault. It is used by the env rule to
+// look up a registered Parser when no EnvToValueFunc is set.
+func (m MetaField) valueType() reflect.Type {
+	if m.target.IsValid() {
+		return m.target.Type()
+	}
+	if m.FlagValueP != nil {
+		t := reflect.TypeOf(m.FlagValueP)
+		if t.Kind() == reflect.Pointer {
+			return t.Elem()
+		}
+	}
+	for _, v := range []any{m.DefaultValue, m.DevDefault, m.ReleaseDefault} {
+		if v != nil {
+			return reflect.TypeOf(v)
+		}
+	}
+	return nil
+}
+
 func (m MetaField) Value() any {
 	rules := m.ValueRules
 	var result any
@@ -2,6 +2,7 @@ package configkit
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 )
 
@@ -14,7 +15,39 @@ func ApplyValues(config any, metaConfig any) error {
 	metaFields := AllMetaFields(metaConfig)
 	for _, metaField := range metaFields {
 		configField := configVal.FieldByName(metaField.FieldName)
-		configField.Set(reflect.ValueOf(metaField.Value()))
+		value, err := coerceValue(metaField.Value(), configField.Type())
+		if err != nil {
+			return fmt.Errorf("configkit: %s: %w", metaField.FieldName, err)
+		}
+		configField.Set(value)
 	}
 	return nil
 }
+
+// coerceValue adapts a resolved MetaField value (any of: a value already of fieldType, one
+// convertible to it, or a raw value decoded by a file/env parser, e.g. the string "5s" or the
+// float64 encoding/json decodes every JSON number into) into a reflect.Value assignable to
+// fieldType. FileRule in particular hands back whatever its underlying file format decoded a
+// value as, with no knowledge of the target field's type, so this is also where a field with a
+// registered Parser (e.g. time.Duration, ByteSize) gets re-parsed from that raw form.
+func coerceValue(value any, fieldType reflect.Type) (reflect.Value, error) {
+	val := reflect.ValueOf(value)
+	if val.Type() == fieldType {
+		return val, nil
+	}
+	if val.Type().ConvertibleTo(fieldType) {
+		return val.Convert(fieldType), nil
+	}
+	if parser, ok := ParserFor(fieldType); ok {
+		parsed, err := parser(fmt.Sprintf("%v", value))
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid value %v: %w", value, err)
+		}
+		parsedVal := reflect.ValueOf(parsed)
+		if parsedVal.Type() != fieldType && parsedVal.Type().ConvertibleTo(fieldType) {
+			parsedVal = parsedVal.Convert(fieldType)
+		}
+		return parsedVal, nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot assign %T to %s", value, fieldType)
+}
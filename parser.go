@@ -0,0 +1,144 @@
+package configkit
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Parser converts a raw string, typically read from an environment variable, into a typed
+// value.
+type Parser func(string) (any, error)
+
+var parsers = map[reflect.Type]Parser{}
+
+func init() {
+	RegisterParser(reflect.TypeOf(time.Duration(0)), func(s string) (any, error) {
+		return time.ParseDuration(s)
+	})
+	RegisterParser(reflect.TypeOf(time.Time{}), func(s string) (any, error) {
+		return time.Parse(time.RFC3339, s)
+	})
+	RegisterParser(reflect.TypeOf(url.URL{}), func(s string) (any, error) {
+		u, err := url.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return *u, nil
+	})
+	RegisterParser(reflect.TypeOf([]string(nil)), func(s string) (any, error) {
+		return splitEscaped(s), nil
+	})
+	RegisterParser(reflect.TypeOf(map[string]string(nil)), func(s string) (any, error) {
+		return parseStringMap(s)
+	})
+	RegisterParser(reflect.TypeOf(ByteSize(0)), func(s string) (any, error) {
+		return parseByteSize(s)
+	})
+	RegisterParser(reflect.TypeOf(netip.Addr{}), func(s string) (any, error) {
+		return netip.ParseAddr(s)
+	})
+}
+
+// RegisterParser registers p as the Parser used to convert an environment variable into a
+// value of type t, for any MetaField whose value type is t and that doesn't set its own
+// EnvToValueFunc. Applications can call it to add parsers for their own field kinds.
+func RegisterParser(t reflect.Type, p Parser) {
+	parsers[t] = p
+}
+
+// ParserFor returns the Parser registered for t, if any.
+func ParserFor(t reflect.Type) (Parser, bool) {
+	p, ok := parsers[t]
+	return p, ok
+}
+
+// ByteSize is a count of bytes, with a registered Parser that understands binary suffixes
+// ("10KiB", "2MiB") and decimal ones ("10KB", "2GB"), in addition to a bare integer.
+type ByteSize int64
+
+var byteSizeUnits = []struct {
+	suffix string
+	factor int64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"GiB", 1 << 30},
+	{"TiB", 1 << 40},
+	{"KB", 1_000},
+	{"MB", 1_000_000},
+	{"GB", 1_000_000_000},
+	{"TB", 1_000_000_000_000},
+	{"B", 1},
+}
+
+func parseByteSize(s string) (ByteSize, error) {
+	trimmed := strings.TrimSpace(s)
+	for _, unit := range byteSizeUnits {
+		if !strings.HasSuffix(trimmed, unit.suffix) {
+			continue
+		}
+		num, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(trimmed, unit.suffix)), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid byte size %q: %w", s, err)
+		}
+		return ByteSize(num * float64(unit.factor)), nil
+	}
+
+	num, err := strconv.ParseInt(trimmed, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q: no recognized unit suffix", s)
+	}
+	return ByteSize(num), nil
+}
+
+// splitEscaped splits s on unescaped commas, so a literal comma can be included in an element
+// by writing it as "\,".
+func splitEscaped(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	var result []string
+	var cur strings.Builder
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			result = append(result, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	result = append(result, cur.String())
+
+	return result
+}
+
+func parseStringMap(s string) (map[string]string, error) {
+	result := map[string]string{}
+	if s == "" {
+		return result, nil
+	}
+
+	for _, pair := range splitEscaped(s) {
+		key, val, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid map entry %q, expected k=v", pair)
+		}
+		result[key] = val
+	}
+
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package configkit
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ByteSize
+		wantErr bool
+	}{
+		{"1024", 1024, false},
+		{"  2048  ", 2048, false},
+		{"10KiB", 10 * 1024, false},
+		{"2MiB", 2 * 1024 * 1024, false},
+		{"10KB", 10_000, false},
+		{"2GB", 2_000_000_000, false},
+		{"not a size", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseByteSize(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseByteSize(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseByteSize(%q) returned error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseByteSize(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+type parserTestConfig struct {
+	MaxUpload ByteSize
+}
+
+func TestEnvRuleParsesBareIntegerByteSize(t *testing.T) {
+	field := MetaField{
+		FieldName: "MaxUpload",
+		EnvKey:    "PARSER_TEST_MAX_UPLOAD",
+		FlagValueP: func() *ByteSize {
+			var v ByteSize
+			return &v
+		}(),
+	}
+
+	os.Setenv("PARSER_TEST_MAX_UPLOAD", "1024")
+	defer os.Unsetenv("PARSER_TEST_MAX_UPLOAD")
+
+	got := field.Value()
+	if got != ByteSize(1024) {
+		t.Errorf("Value() = %v, want %v", got, ByteSize(1024))
+	}
+}
+
+func TestEnvRuleDefersOnUnparsableValue(t *testing.T) {
+	field := MetaField{
+		FieldName: "MaxUpload",
+		EnvKey:    "PARSER_TEST_MAX_UPLOAD",
+		FlagValueP: func() *ByteSize {
+			var v ByteSize
+			return &v
+		}(),
+		DefaultValue: ByteSize(512),
+	}
+
+	os.Setenv("PARSER_TEST_MAX_UPLOAD", "not a size")
+	defer os.Unsetenv("PARSER_TEST_MAX_UPLOAD")
+
+	got := field.Value()
+	if got != ByteSize(512) {
+		t.Errorf("Value() = %v, want the default %v when the env value fails to parse", got, ByteSize(512))
+	}
+}
@@ -0,0 +1,201 @@
+package configkit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Paths returns the dotted field paths of every leaf field reachable from cfg, a struct or a
+// pointer to one. Nested structs (and pointers to structs) are descended into and joined with
+// ".", e.g. "SubConfig.FieldFour".
+//
+// Panic Conditions:
+//
+//   - If cfg is not a struct or a pointer to a struct
+func Paths(cfg any) []string {
+	return typePaths(baseStructType(cfg), "")
+}
+
+func typePaths(t reflect.Type, prefix string) []string {
+	var paths []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		path := field.Name
+		if prefix != "" {
+			path = prefix + "." + field.Name
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && fieldType != reflect.TypeOf(time.Time{}) {
+			paths = append(paths, typePaths(fieldType, path)...)
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// FieldRef is a typed handle to a single leaf field of a config struct, addressed by its
+// dotted path. It is returned by FieldOptions so CLI tools can build survey/prompt-style
+// pickers over an entire config tree without knowing its shape in advance.
+type FieldRef struct {
+	Path string
+	Kind reflect.Kind
+	Type reflect.Type
+
+	cfg any
+}
+
+// Get returns the current value at this field's path, or nil if a nillable substruct along
+// the path hasn't been allocated yet.
+func (r FieldRef) Get() any {
+	val, ok := navigate(r.cfg, r.Path, false)
+	if !ok {
+		return nil
+	}
+	return val.Interface()
+}
+
+// Set parses raw according to this field's kind and writes it back through cfg, lazily
+// allocating any nil substructs along the path.
+func (r FieldRef) Set(raw string) error {
+	return SetByPath(r.cfg, r.Path, raw)
+}
+
+// FieldOptions returns every leaf field reachable from cfg, a pointer to a struct, keyed by
+// its stable dotted path.
+//
+// Panic Conditions:
+//
+//   - If cfg is not a pointer to a struct
+func FieldOptions(cfg any) map[string]FieldRef {
+	requirePointerToStruct(cfg)
+
+	refs := map[string]FieldRef{}
+	for _, path := range Paths(cfg) {
+		segments := strings.Split(path, ".")
+		t := baseStructType(cfg)
+		var fieldType reflect.Type
+		for i, seg := range segments {
+			field, _ := t.FieldByName(seg)
+			fieldType = field.Type
+			if i < len(segments)-1 && fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			t = fieldType
+		}
+		refs[path] = FieldRef{Path: path, Kind: fieldType.Kind(), Type: fieldType, cfg: cfg}
+	}
+	return refs
+}
+
+// SetByPath navigates cfg, a pointer to a struct, to the field addressed by the dotted path
+// (e.g. "SubConfig.FieldFour"), parses raw according to that field's kind, and writes it back.
+// Nil substructs along the path are allocated as needed.
+func SetByPath(cfg any, path string, raw string) error {
+	val, ok := navigate(cfg, path, true)
+	if !ok {
+		return fmt.Errorf("configkit: path %q not found", path)
+	}
+	return setFieldValue(val, raw)
+}
+
+// navigate walks cfg, a pointer to a struct, along path. When allocate is true, nil pointer
+// substructs encountered along the way are allocated; when false, navigate stops and returns
+// false instead of allocating.
+func navigate(cfg any, path string, allocate bool) (reflect.Value, bool) {
+	requirePointerToStruct(cfg)
+
+	val := reflect.ValueOf(cfg).Elem()
+	for _, seg := range strings.Split(path, ".") {
+		if val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				if !allocate {
+					return reflect.Value{}, false
+				}
+				val.Set(reflect.New(val.Type().Elem()))
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		val = val.FieldByName(seg)
+		if !val.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	if val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			if !allocate {
+				return reflect.Value{}, false
+			}
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+		val = val.Elem()
+	}
+	return val, true
+}
+
+func setFieldValue(val reflect.Value, raw string) error {
+	switch {
+	case val.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("configkit: invalid duration %q: %w", raw, err)
+		}
+		val.Set(reflect.ValueOf(d))
+	case val.Type() == reflect.TypeOf(time.Time{}):
+		ts, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fmt.Errorf("configkit: invalid time %q: %w", raw, err)
+		}
+		val.Set(reflect.ValueOf(ts))
+	case val.Kind() == reflect.String:
+		val.Set(reflect.ValueOf(raw).Convert(val.Type()))
+	case val.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("configkit: invalid bool %q: %w", raw, err)
+		}
+		val.SetBool(b)
+	case val.Kind() >= reflect.Int && val.Kind() <= reflect.Int64:
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("configkit: invalid int %q: %w", raw, err)
+		}
+		val.SetInt(i)
+	default:
+		return fmt.Errorf("configkit: unsupported field kind %s", val.Kind())
+	}
+	return nil
+}
+
+func baseStructType(cfg any) reflect.Type {
+	t := reflect.TypeOf(cfg)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		panic(errors.New("configkit: input is not a struct or a pointer to a struct"))
+	}
+	return t
+}
+
+func requirePointerToStruct(cfg any) {
+	val := reflect.ValueOf(cfg)
+	if val.Kind() != reflect.Pointer || val.Elem().Kind() != reflect.Struct {
+		panic(errors.New("configkit: input is not a pointer to a struct"))
+	}
+}
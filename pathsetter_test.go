@@ -0,0 +1,73 @@
+package configkit
+
+import (
+	"testing"
+	"time"
+)
+
+type pathsetterTestSub struct {
+	FieldFour int
+}
+
+type pathsetterTestConfig struct {
+	FieldOne  string
+	StartedAt time.Time
+	Sub       pathsetterTestSub
+}
+
+func TestPathsIncludesTimeTimeAsLeaf(t *testing.T) {
+	paths := Paths(&pathsetterTestConfig{})
+
+	found := false
+	for _, p := range paths {
+		if p == "StartedAt" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Paths() = %v, want it to include %q", paths, "StartedAt")
+	}
+}
+
+func TestSetByPathSetsTimeTime(t *testing.T) {
+	cfg := pathsetterTestConfig{}
+
+	if err := SetByPath(&cfg, "StartedAt", "2024-01-01T00:00:00Z"); err != nil {
+		t.Fatalf("SetByPath: %v", err)
+	}
+
+	want, err := time.Parse(time.RFC3339, "2024-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("time.Parse: %v", err)
+	}
+	if !cfg.StartedAt.Equal(want) {
+		t.Errorf("StartedAt = %v, want %v", cfg.StartedAt, want)
+	}
+}
+
+func TestSetByPathRejectsInvalidTime(t *testing.T) {
+	cfg := pathsetterTestConfig{}
+
+	if err := SetByPath(&cfg, "StartedAt", "not a time"); err == nil {
+		t.Error("SetByPath: want error for invalid time, got nil")
+	}
+}
+
+func TestSetByPathNestedField(t *testing.T) {
+	cfg := pathsetterTestConfig{}
+
+	if err := SetByPath(&cfg, "Sub.FieldFour", "42"); err != nil {
+		t.Fatalf("SetByPath: %v", err)
+	}
+	if cfg.Sub.FieldFour != 42 {
+		t.Errorf("Sub.FieldFour = %d, want 42", cfg.Sub.FieldFour)
+	}
+}
+
+func TestSetByPathUnknownPath(t *testing.T) {
+	cfg := pathsetterTestConfig{}
+
+	if err := SetByPath(&cfg, "DoesNotExist", "x"); err == nil {
+		t.Error("SetByPath: want error for unknown path, got nil")
+	}
+}
@@ -0,0 +1,357 @@
+package configkit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// structTag is the struct tag key RegisterStruct looks for.
+const structTag = "configkit"
+
+// RegisterStruct walks cfg, a pointer to a struct, looking for `configkit:"..."` tags and
+// builds a MetaField for each tagged leaf field it finds. A cobra flag is registered on cmd
+// for every leaf, using the field's kind to pick the right cobra flag constructor.
+//
+// Tag options are comma separated key=value pairs:
+//
+//   - flag=<name>    the cobra flag name (defaults to the field name, kebab-cased)
+//   - env=<key>      the environment variable key
+//   - default=<val>  the default value, parsed according to the field's kind
+//   - devDefault=<val>, releaseDefault=<val>
+//     defaults selected by the active Defaults mode (see SetDefaultsMode) instead of a single
+//     default=. Setting both and a default= is an error.
+//   - usage=<text>   the flag usage string
+//
+// Two bare options control nested structs instead of leaf fields:
+//
+//   - ,inline        descend into the struct without adding a flag/env prefix
+//   - prefix=<name>  descend into the struct, prepending <name> (kebab-cased for flags,
+//     SCREAMING_SNAKE_CASE for env keys) to every field beneath it
+//
+// A nested struct with no tag at all is still descended into, using its field name as the
+// implicit prefix. This lets a real Config struct double as its own metaConfig, removing the
+// need to hand-build a parallel struct of MetaFields.
+//
+// Panic Conditions:
+//
+//   - If cfg is not a pointer to a struct
+func RegisterStruct(cfg any, cmd *cobra.Command) ([]MetaField, error) {
+	val := reflect.ValueOf(cfg)
+	if val.Kind() != reflect.Pointer {
+		panic(errors.New("configkit: RegisterStruct: input is not a pointer to a struct"))
+	}
+	val = val.Elem()
+	if val.Kind() != reflect.Struct {
+		panic(errors.New("configkit: RegisterStruct: input is not a pointer to a struct"))
+	}
+
+	return registerStruct(val, cmd, "", "", "")
+}
+
+func registerStruct(val reflect.Value, cmd *cobra.Command, flagPrefix, envPrefix, dottedPrefix string) ([]MetaField, error) {
+	var fields []MetaField
+
+	for i := 0; i < val.NumField(); i++ {
+		fieldVal := val.Field(i)
+		structField := val.Type().Field(i)
+		if !fieldVal.CanAddr() || !structField.IsExported() {
+			continue
+		}
+
+		opts := parseTag(structField.Tag.Get(structTag))
+		dottedPath := joinPrefix(dottedPrefix, structField.Name, ".")
+
+		if fieldVal.Kind() == reflect.Struct {
+			nestedFlagPrefix, nestedEnvPrefix := flagPrefix, envPrefix
+			if _, inline := opts["inline"]; !inline {
+				prefix := opts["prefix"]
+				if prefix == "" {
+					prefix = structField.Name
+				}
+				nestedFlagPrefix = joinPrefix(flagPrefix, toKebabCase(prefix), "-")
+				nestedEnvPrefix = joinPrefix(envPrefix, toScreamingSnakeCase(prefix), "_")
+			}
+			nested, err := registerStruct(fieldVal, cmd, nestedFlagPrefix, nestedEnvPrefix, dottedPath)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", structField.Name, err)
+			}
+			fields = append(fields, nested...)
+			continue
+		}
+
+		if len(opts) == 0 {
+			continue
+		}
+
+		field, err := registerLeaf(fieldVal, structField, opts, cmd, flagPrefix, envPrefix, dottedPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", structField.Name, err)
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func registerLeaf(fieldVal reflect.Value, structField reflect.StructField, opts map[string]string, cmd *cobra.Command, flagPrefix, envPrefix, dottedPath string) (MetaField, error) {
+	flagName := joinPrefix(flagPrefix, toKebabCase(valueOr(opts["flag"], structField.Name)), "-")
+	envKey := opts["env"]
+	if envKey != "" {
+		envKey = joinPrefix(envPrefix, envKey, "_")
+	}
+
+	metaField := MetaField{
+		FieldName: structField.Name,
+		Path:      dottedPath,
+		EnvKey:    envKey,
+		Metadata: map[string]string{
+			"flag_name":  flagName,
+			"flag_usage": opts["usage"],
+		},
+		target: fieldVal,
+	}
+
+	addr := fieldVal.Addr()
+
+	switch fieldVal.Kind() {
+	case reflect.String:
+		metaField.DefaultValue = valueOr(opts["default"], "")
+		metaField.FlagValueP = addr.Interface()
+		metaField.EnvToValueFunc = func(s string) any { return s }
+		metaField.CobraSetupFunc = func(f MetaField, cmd *cobra.Command) {
+			cmd.Flags().StringVar(f.FlagValueP.(*string), f.Metadata["flag_name"], f.DefaultValue.(string), f.Metadata["flag_usage"])
+		}
+	case reflect.Int, reflect.Int64:
+		if fieldVal.Type() == reflect.TypeOf(time.Duration(0)) {
+			def, err := parseDurationDefault(opts["default"])
+			if err != nil {
+				return MetaField{}, err
+			}
+			metaField.DefaultValue = def
+			metaField.FlagValueP = addr.Interface()
+			metaField.EnvToValueFunc = func(s string) any {
+				d, _ := time.ParseDuration(s)
+				return d
+			}
+			metaField.CobraSetupFunc = func(f MetaField, cmd *cobra.Command) {
+				cmd.Flags().DurationVar(f.FlagValueP.(*time.Duration), f.Metadata["flag_name"], f.DefaultValue.(time.Duration), f.Metadata["flag_usage"])
+			}
+			break
+		}
+		def, err := parseIntDefault(opts["default"])
+		if err != nil {
+			return MetaField{}, err
+		}
+		metaField.DefaultValue = def
+		metaField.FlagValueP = addr.Interface()
+		metaField.EnvToValueFunc = func(s string) any {
+			v, _ := strconv.Atoi(s)
+			return v
+		}
+		metaField.CobraSetupFunc = func(f MetaField, cmd *cobra.Command) {
+			cmd.Flags().IntVar(f.FlagValueP.(*int), f.Metadata["flag_name"], f.DefaultValue.(int), f.Metadata["flag_usage"])
+		}
+	case reflect.Bool:
+		def, err := parseBoolDefault(opts["default"])
+		if err != nil {
+			return MetaField{}, err
+		}
+		metaField.DefaultValue = def
+		metaField.FlagValueP = addr.Interface()
+		metaField.EnvToValueFunc = func(s string) any {
+			b, _ := strconv.ParseBool(s)
+			return b
+		}
+		metaField.CobraSetupFunc = func(f MetaField, cmd *cobra.Command) {
+			cmd.Flags().BoolVar(f.FlagValueP.(*bool), f.Metadata["flag_name"], f.DefaultValue.(bool), f.Metadata["flag_usage"])
+		}
+	case reflect.Slice:
+		if fieldVal.Type().Elem().Kind() != reflect.String {
+			return MetaField{}, fmt.Errorf("unsupported slice element type %s", fieldVal.Type().Elem())
+		}
+		var def []string
+		if opts["default"] != "" {
+			def = strings.Split(opts["default"], ";")
+		}
+		metaField.DefaultValue = def
+		metaField.FlagValueP = addr.Interface()
+		metaField.EnvToValueFunc = func(s string) any { return strings.Split(s, ";") }
+		metaField.CobraSetupFunc = func(f MetaField, cmd *cobra.Command) {
+			cmd.Flags().StringSliceVar(f.FlagValueP.(*[]string), f.Metadata["flag_name"], f.DefaultValue.([]string), f.Metadata["flag_usage"])
+		}
+	default:
+		return MetaField{}, fmt.Errorf("unsupported field kind %s", fieldVal.Kind())
+	}
+
+	if opts["devDefault"] != "" && opts["releaseDefault"] != "" {
+		if opts["default"] != "" {
+			return MetaField{}, fmt.Errorf("devDefault and releaseDefault are both set, default must be empty")
+		}
+		metaField.DefaultValue = nil
+	}
+	if opts["devDefault"] != "" {
+		dev, err := parseDefaultForKind(fieldVal, opts["devDefault"])
+		if err != nil {
+			return MetaField{}, err
+		}
+		metaField.DevDefault = dev
+	}
+	if opts["releaseDefault"] != "" {
+		release, err := parseDefaultForKind(fieldVal, opts["releaseDefault"])
+		if err != nil {
+			return MetaField{}, err
+		}
+		metaField.ReleaseDefault = release
+	}
+
+	if cmd != nil {
+		// metaField.DefaultValue is nil when both devDefault and releaseDefault were given
+		// (see above): CobraSetupFunc still needs a concrete value of the right type to seed
+		// the flag with, so resolve one via the active Defaults mode without touching the
+		// MetaField that gets returned to the caller.
+		cobraField := metaField
+		if cobraField.DefaultValue == nil {
+			cobraField.DefaultValue = effectiveDefault(metaField)
+		}
+		cobraField.CobraSetupFunc(cobraField, cmd)
+
+		flagName := metaField.Metadata["flag_name"]
+		metaField.FlagChanged = func() bool { return cmd.Flags().Changed(flagName) }
+	}
+
+	return metaField, nil
+}
+
+// effectiveDefault resolves the DefaultValue CobraSetupFunc should seed a flag with when
+// MetaField.DefaultValue itself is nil, i.e. only DevDefault/ReleaseDefault were set.
+func effectiveDefault(m MetaField) any {
+	if resolveDefaultsMode() == DefaultsDev && m.DevDefault != nil {
+		return m.DevDefault
+	}
+	if m.ReleaseDefault != nil {
+		return m.ReleaseDefault
+	}
+	return m.DevDefault
+}
+
+// ApplyRegistered resolves the value of each MetaField returned by RegisterStruct and writes
+// it back onto the struct field it was registered from. Call it once cmd has parsed flags.
+func ApplyRegistered(fields []MetaField) error {
+	for _, field := range fields {
+		if !field.target.IsValid() {
+			return fmt.Errorf("configkit: %s was not registered via RegisterStruct", field.FieldName)
+		}
+		value, err := coerceValue(field.Value(), field.target.Type())
+		if err != nil {
+			return fmt.Errorf("configkit: %s: %w", field.FieldName, err)
+		}
+		field.target.Set(value)
+	}
+	return nil
+}
+
+func parseTag(tag string) map[string]string {
+	opts := map[string]string{}
+	if tag == "" {
+		return opts
+	}
+	for _, part := range strings.Split(tag, ",") {
+		if part == "" {
+			continue
+		}
+		key, val, found := strings.Cut(part, "=")
+		if !found {
+			opts[key] = "true"
+			continue
+		}
+		opts[key] = val
+	}
+	return opts
+}
+
+// parseDefaultForKind parses a devDefault/releaseDefault tag value using the same rules as
+// the default= tag, based on fieldVal's kind.
+func parseDefaultForKind(fieldVal reflect.Value, s string) (any, error) {
+	switch fieldVal.Kind() {
+	case reflect.String:
+		return s, nil
+	case reflect.Int, reflect.Int64:
+		if fieldVal.Type() == reflect.TypeOf(time.Duration(0)) {
+			return parseDurationDefault(s)
+		}
+		return parseIntDefault(s)
+	case reflect.Bool:
+		return parseBoolDefault(s)
+	case reflect.Slice:
+		if s == "" {
+			return []string(nil), nil
+		}
+		return strings.Split(s, ";"), nil
+	default:
+		return nil, fmt.Errorf("unsupported field kind %s", fieldVal.Kind())
+	}
+}
+
+func parseIntDefault(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid int default %q: %w", s, err)
+	}
+	return v, nil
+}
+
+func parseBoolDefault(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("invalid bool default %q: %w", s, err)
+	}
+	return v, nil
+}
+
+func parseDurationDefault(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration default %q: %w", s, err)
+	}
+	return v, nil
+}
+
+func valueOr(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
+
+func joinPrefix(prefix, name, sep string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + sep + name
+}
+
+var kebabBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func toKebabCase(s string) string {
+	return strings.ToLower(kebabBoundary.ReplaceAllString(s, "$1-$2"))
+}
+
+func toScreamingSnakeCase(s string) string {
+	return strings.ToUpper(kebabBoundary.ReplaceAllString(s, "$1_$2"))
+}
@@ -0,0 +1,64 @@
+package configkit
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type tagsTestConfig struct {
+	FieldOne string `configkit:"flag=field-one,env=TAGS_TEST_FIELD_ONE,default=default value"`
+}
+
+func registerTagsTestConfig(t *testing.T, args []string) tagsTestConfig {
+	t.Helper()
+
+	cmd := &cobra.Command{Use: "test", Run: func(cmd *cobra.Command, args []string) {}}
+	cfg := tagsTestConfig{}
+	fields, err := RegisterStruct(&cfg, cmd)
+	if err != nil {
+		t.Fatalf("RegisterStruct: %v", err)
+	}
+
+	// cobra falls back to os.Args (the go test flags) when SetArgs is given nil.
+	if args == nil {
+		args = []string{}
+	}
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if err := ApplyRegistered(fields); err != nil {
+		t.Fatalf("ApplyRegistered: %v", err)
+	}
+
+	return cfg
+}
+
+func TestRegisterStructPrecedenceDefaultAlone(t *testing.T) {
+	cfg := registerTagsTestConfig(t, nil)
+	if cfg.FieldOne != "default value" {
+		t.Errorf("FieldOne = %q, want %q", cfg.FieldOne, "default value")
+	}
+}
+
+func TestRegisterStructPrecedenceEnvOverridesDefault(t *testing.T) {
+	os.Setenv("TAGS_TEST_FIELD_ONE", "from-env")
+	defer os.Unsetenv("TAGS_TEST_FIELD_ONE")
+
+	cfg := registerTagsTestConfig(t, nil)
+	if cfg.FieldOne != "from-env" {
+		t.Errorf("FieldOne = %q, want %q (env must win over an unset flag's default)", cfg.FieldOne, "from-env")
+	}
+}
+
+func TestRegisterStructPrecedenceFlagOverridesEnv(t *testing.T) {
+	os.Setenv("TAGS_TEST_FIELD_ONE", "from-env")
+	defer os.Unsetenv("TAGS_TEST_FIELD_ONE")
+
+	cfg := registerTagsTestConfig(t, []string{"--field-one=from-flag"})
+	if cfg.FieldOne != "from-flag" {
+		t.Errorf("FieldOne = %q, want %q (an explicitly passed flag must win over env)", cfg.FieldOne, "from-flag")
+	}
+}
@@ -0,0 +1,131 @@
+package configkit
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+// Validate resolves the value of every MetaField in metaConfig via AllMetaFields, runs its
+// Required check and Validators against that value, and returns all failures joined together
+// with errors.Join, each one prefixed with its field name. It returns nil if every field
+// passes.
+func Validate(metaConfig any) error {
+	var errs []error
+
+	for _, field := range AllMetaFields(metaConfig) {
+		value := field.Value()
+
+		if field.Required && !hasDefault(field) && isZeroValue(value) {
+			errs = append(errs, fmt.Errorf("%s: value is required", field.FieldName))
+		}
+
+		for _, validator := range field.Validators {
+			if err := validator(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", field.FieldName, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func hasDefault(field MetaField) bool {
+	return field.DefaultValue != nil || field.DevDefault != nil || field.ReleaseDefault != nil
+}
+
+func isZeroValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	return reflect.ValueOf(v).IsZero()
+}
+
+// Required returns a validator that fails when v is the zero value of its type. Unlike
+// MetaField.Required, it fails even when a default was supplied, if that default resolves to
+// the zero value; it composes with other validators in MetaField.Validators.
+func Required() func(any) error {
+	return func(v any) error {
+		if isZeroValue(v) {
+			return errors.New("value is required")
+		}
+		return nil
+	}
+}
+
+// OneOf returns a validator that fails unless v equals one of vals.
+func OneOf(vals ...any) func(any) error {
+	return func(v any) error {
+		for _, allowed := range vals {
+			if v == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %v is not one of %v", v, vals)
+	}
+}
+
+// IntRange returns a validator that fails unless v is an int in the inclusive range [min, max].
+func IntRange(min, max int) func(any) error {
+	return func(v any) error {
+		i, ok := v.(int)
+		if !ok {
+			return fmt.Errorf("value %v is not an int", v)
+		}
+		if i < min || i > max {
+			return fmt.Errorf("value %d is outside the range [%d, %d]", i, min, max)
+		}
+		return nil
+	}
+}
+
+// Regexp returns a validator that fails unless v is a string matching pat.
+func Regexp(pat string) func(any) error {
+	re := regexp.MustCompile(pat)
+	return func(v any) error {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("value %v is not a string", v)
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("value %q does not match pattern %q", s, pat)
+		}
+		return nil
+	}
+}
+
+// URL returns a validator that fails unless v is a string containing an absolute URL.
+func URL() func(any) error {
+	return func(v any) error {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("value %v is not a string", v)
+		}
+		parsed, err := url.ParseRequestURI(s)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("value %q is not a valid URL", s)
+		}
+		return nil
+	}
+}
+
+// Duration returns a validator that fails unless v is a time.Duration, or a string that
+// time.ParseDuration accepts.
+func Duration() func(any) error {
+	return func(v any) error {
+		switch value := v.(type) {
+		case time.Duration:
+			return nil
+		case string:
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %q is not a valid duration: %w", value, err)
+			}
+			return nil
+		default:
+			return fmt.Errorf("value %v is not a duration", v)
+		}
+	}
+}
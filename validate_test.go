@@ -0,0 +1,113 @@
+package configkit
+
+import (
+	"strings"
+	"testing"
+)
+
+type validateTestConfig struct {
+	Name  MetaField
+	Level MetaField
+}
+
+func TestValidateRequiredFailsOnZeroValue(t *testing.T) {
+	cfg := validateTestConfig{
+		Name: MetaField{FieldName: "Name", Required: true},
+	}
+
+	err := Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "Name: value is required") {
+		t.Errorf("Validate() = %v, want an error mentioning Name is required", err)
+	}
+}
+
+func TestValidateRequiredPassesWithDefault(t *testing.T) {
+	cfg := validateTestConfig{
+		Name: MetaField{FieldName: "Name", Required: true, DefaultValue: "anonymous"},
+	}
+
+	if err := Validate(cfg); err != nil {
+		t.Errorf("Validate() = %v, want nil since a default satisfies Required", err)
+	}
+}
+
+func TestValidateRunsValidators(t *testing.T) {
+	cfg := validateTestConfig{
+		Level: MetaField{
+			FieldName:    "Level",
+			DefaultValue: 99,
+			Validators:   []func(any) error{IntRange(0, 10)},
+		},
+	}
+
+	err := Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "Level:") {
+		t.Errorf("Validate() = %v, want an error from the IntRange validator", err)
+	}
+}
+
+func TestRequiredValidator(t *testing.T) {
+	v := Required()
+	if err := v(""); err == nil {
+		t.Error("Required()(\"\") = nil, want error")
+	}
+	if err := v("set"); err != nil {
+		t.Errorf("Required()(\"set\") = %v, want nil", err)
+	}
+}
+
+func TestOneOfValidator(t *testing.T) {
+	v := OneOf("a", "b", "c")
+	if err := v("b"); err != nil {
+		t.Errorf("OneOf()(\"b\") = %v, want nil", err)
+	}
+	if err := v("z"); err == nil {
+		t.Error("OneOf()(\"z\") = nil, want error")
+	}
+}
+
+func TestIntRangeValidator(t *testing.T) {
+	v := IntRange(1, 5)
+	if err := v(3); err != nil {
+		t.Errorf("IntRange(1,5)(3) = %v, want nil", err)
+	}
+	if err := v(6); err == nil {
+		t.Error("IntRange(1,5)(6) = nil, want error")
+	}
+	if err := v("not an int"); err == nil {
+		t.Error("IntRange(1,5)(\"not an int\") = nil, want error")
+	}
+}
+
+func TestRegexpValidator(t *testing.T) {
+	v := Regexp(`^[a-z]+$`)
+	if err := v("abc"); err != nil {
+		t.Errorf("Regexp()(\"abc\") = %v, want nil", err)
+	}
+	if err := v("ABC"); err == nil {
+		t.Error("Regexp()(\"ABC\") = nil, want error")
+	}
+}
+
+func TestURLValidator(t *testing.T) {
+	v := URL()
+	if err := v("https://example.com"); err != nil {
+		t.Errorf("URL()(\"https://example.com\") = %v, want nil", err)
+	}
+	if err := v("not a url"); err == nil {
+		t.Error("URL()(\"not a url\") = nil, want error")
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	v := Duration()
+	if err := v("5s"); err != nil {
+		t.Errorf("Duration()(\"5s\") = %v, want nil", err)
+	}
+	if err := v("not a duration"); err == nil {
+		t.Error("Duration()(\"not a duration\") = nil, want error")
+	}
+	if err := v(123); err == nil {
+		t.Error("Duration()(123) = nil, want error")
+	}
+}